@@ -0,0 +1,139 @@
+package finance
+
+import (
+	"fmt"
+	"math"
+)
+
+// AmortizationRow is a single row of an amortization schedule produced by AmortizationSchedule.
+type AmortizationRow struct {
+	Period    int
+	Payment   float64
+	Interest  float64
+	Principal float64
+	Balance   float64
+}
+
+func amortizationPayment(principal, ratePerPeriod, nper float64, payAtBeginning bool) float64 {
+	numerator := buildNumerator(ratePerPeriod, nper, payAtBeginning)
+	denominator := math.Pow(1+ratePerPeriod, nper) - 1
+
+	return principal * (numerator / denominator)
+}
+
+// amortizationRows walks the loan balance period by period, applying the same payAtBeginning
+// shift as buildNumerator: the first payment accrues no interest when payments are made at
+// the start of the period.
+func amortizationRows(principal, ratePerPeriod, nper float64, payAtBeginning bool) []AmortizationRow {
+	payment := amortizationPayment(principal, ratePerPeriod, nper, payAtBeginning)
+	balance := principal
+	rows := make([]AmortizationRow, 0, int(nper))
+
+	for p := 1; float64(p) <= nper; p++ {
+		var interest float64
+		if payAtBeginning && p == 1 {
+			interest = 0
+		} else {
+			interest = balance * ratePerPeriod
+		}
+
+		principalComponent := payment - interest
+		balance -= principalComponent
+
+		rows = append(rows, AmortizationRow{
+			Period:    p,
+			Payment:   math.Round(payment*100) / 100,
+			Interest:  math.Round(interest*100) / 100,
+			Principal: math.Round(principalComponent*100) / 100,
+			Balance:   math.Round(balance*100) / 100,
+		})
+	}
+
+	return rows
+}
+
+// validatePeriod panics with a descriptive message if per falls outside [1, nper], matching
+// the rest of the package's style of panicking on invalid input rather than indexing out of
+// bounds.
+func validatePeriod(per, nper float64) {
+	if per < 1 || per > nper {
+		panic(fmt.Sprintf("finance: per must be between 1 and nper (%v), got %v", nper, per))
+	}
+}
+
+// amortizationBalanceBefore returns the loan balance at the start of period per (i.e. after
+// per-1 periods), in closed form via balance_k = principal*(1+r)^k - payment*((1+r)^k-1)/r,
+// rather than walking amortizationRows one period at a time. payAtBeginning shifts the
+// recursion by one period to match amortizationRows' first-period-accrues-no-interest rule.
+func amortizationBalanceBefore(principal, payment, ratePerPeriod float64, per int, payAtBeginning bool) float64 {
+	start, periodsElapsed := principal, per-1
+
+	if payAtBeginning {
+		start, periodsElapsed = principal-payment, per-2
+	}
+
+	growth := math.Pow(1+ratePerPeriod, float64(periodsElapsed))
+
+	return start*growth - payment*(growth-1)/ratePerPeriod
+}
+
+// amortizationInterest returns the interest component of payment at period per, sharing the
+// payAtBeginning-and-first-period special case between IPMT and PPMT.
+func amortizationInterest(principal, payment, ratePerPeriod float64, per int, payAtBeginning bool) float64 {
+	if payAtBeginning && per == 1 {
+		return 0
+	}
+
+	balance := amortizationBalanceBefore(principal, payment, ratePerPeriod, per, payAtBeginning)
+
+	return balance * ratePerPeriod
+}
+
+// IPMT - Interest Payment
+//
+// IPMT returns the interest component of a loan's fixed periodic payment for period per.
+// rate is the nominal annual interest rate as a percentage and nper is the total number of
+// monthly periods, the same convention as AM and AmortizationSchedule.
+func IPMT(rate, per, nper, principal float64, payAtBeginning bool) float64 {
+	validatePeriod(per, nper)
+
+	ratePerPeriod := rate / 12 / 100
+	payment := amortizationPayment(principal, ratePerPeriod, nper, payAtBeginning)
+	interest := amortizationInterest(principal, payment, ratePerPeriod, int(per), payAtBeginning)
+
+	return math.Round(interest*100) / 100
+}
+
+// PPMT - Principal Payment
+//
+// PPMT returns the principal component of a loan's fixed periodic payment for period per;
+// see IPMT for the shared parameters and rate convention.
+func PPMT(rate, per, nper, principal float64, payAtBeginning bool) float64 {
+	validatePeriod(per, nper)
+
+	ratePerPeriod := rate / 12 / 100
+	payment := amortizationPayment(principal, ratePerPeriod, nper, payAtBeginning)
+	interest := amortizationInterest(principal, payment, ratePerPeriod, int(per), payAtBeginning)
+
+	return math.Round((payment-interest)*100) / 100
+}
+
+// AmortizationSchedule - Amortization Schedule
+//
+// AmortizationSchedule returns the full period-by-period breakdown of a loan amortized with
+// AM: each row's payment, interest and principal components, and the remaining balance.
+// paymentType can be either 'months' or 'years', matching AM.
+func AmortizationSchedule(principal, rate, period float64, paymentType PaymentType, payAtBeginning bool) []AmortizationRow {
+	if paymentType != Years && paymentType != Months {
+		panic("paymentType should be either Months or Years")
+	}
+
+	ratePerPeriod := rate / 12 / 100
+
+	nper := period
+	if paymentType == Years {
+		nper = period * 12
+	}
+
+	return amortizationRows(principal, ratePerPeriod, nper, payAtBeginning)
+}
@@ -0,0 +1,83 @@
+package finance
+
+import (
+	"testing"
+)
+
+func TestIPMTPPMT(t *testing.T) {
+	t.Run("Compute IPMT and PPMT", func(t *testing.T) {
+		entries := []struct {
+			rate, per, nper, principal float64
+			payAtBeginning             bool
+			wantInterest               float64
+			wantPrincipal              float64
+		}{
+			{7.5, 1, 60, 20000, false, 125, 275.76},
+			{7.5, 1, 60, 20000, true, 0, 398.27},
+		}
+
+		for _, entry := range entries {
+			gotInterest := IPMT(entry.rate, entry.per, entry.nper, entry.principal, entry.payAtBeginning)
+			gotPrincipal := PPMT(entry.rate, entry.per, entry.nper, entry.principal, entry.payAtBeginning)
+
+			if gotInterest != entry.wantInterest {
+				t.Errorf("Expected interest %v to be equal %v", gotInterest, entry.wantInterest)
+			}
+			if gotPrincipal != entry.wantPrincipal {
+				t.Errorf("Expected principal %v to be equal %v", gotPrincipal, entry.wantPrincipal)
+			}
+		}
+	})
+
+	t.Run("Panics when per is out of range", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected a panic for an out-of-range per")
+			}
+		}()
+
+		IPMT(7.5, 61, 60, 20000, false)
+	})
+}
+
+func TestAmortizationSchedule(t *testing.T) {
+	t.Run("Compute AmortizationSchedule", func(t *testing.T) {
+		rows := AmortizationSchedule(20000, 7.5, 5, Years, false)
+
+		if len(rows) != 60 {
+			t.Fatalf("Expected 60 rows, got %v", len(rows))
+		}
+
+		first, last := rows[0], rows[59]
+
+		if first.Payment != 400.76 || first.Interest != 125 || first.Principal != 275.76 {
+			t.Errorf("Unexpected first row: %+v", first)
+		}
+		if last.Balance != 0 {
+			t.Errorf("Expected final balance to be 0, got %v", last.Balance)
+		}
+	})
+
+	t.Run("Compute AmortizationSchedule with payment at beginning", func(t *testing.T) {
+		rows := AmortizationSchedule(20000, 7.5, 5, Years, true)
+
+		first, last := rows[0], rows[59]
+
+		if first.Interest != 0 || first.Principal != first.Payment {
+			t.Errorf("Expected no interest on the first row when paying at the beginning, got %+v", first)
+		}
+		if last.Balance != 0 {
+			t.Errorf("Expected final balance to be 0, got %v", last.Balance)
+		}
+	})
+
+	t.Run("Panics on invalid paymentType", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected a panic for an invalid paymentType")
+			}
+		}()
+
+		AmortizationSchedule(20000, 7.5, 5, PaymentType(99), false)
+	})
+}
@@ -0,0 +1,154 @@
+package finance
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	bondMaxIterations = 50
+	bondTolerance     = 1e-9
+)
+
+// bondPriceAtYield returns the price of a periods, freq-per-year coupon bond discounted at
+// annualYield (a fraction, not a percentage).
+func bondPriceAtYield(face, couponRate, annualYield float64, periods, freq int) float64 {
+	m := float64(freq)
+	coupon := face * couponRate / 100 / m
+	periodRate := annualYield / m
+
+	price := 0.0
+	for t := 1; t <= periods; t++ {
+		price += coupon / math.Pow(1+periodRate, float64(t))
+	}
+	price += face / math.Pow(1+periodRate, float64(periods))
+
+	return price
+}
+
+// bondPriceDerivative returns d/dy of bondPriceAtYield, used by Newton-Raphson in BondYTM.
+func bondPriceDerivative(face, couponRate, annualYield float64, periods, freq int) float64 {
+	m := float64(freq)
+	coupon := face * couponRate / 100 / m
+	periodRate := annualYield / m
+
+	derivative := 0.0
+	for t := 1; t <= periods; t++ {
+		derivative += -float64(t) * coupon / (m * math.Pow(1+periodRate, float64(t+1)))
+	}
+	derivative += -float64(periods) * face / (m * math.Pow(1+periodRate, float64(periods+1)))
+
+	return derivative
+}
+
+// BondPrice - Bond Price
+//
+// BondPrice values a coupon bond as the sum of its discounted coupon payments and face value.
+// face is the principal repaid at maturity, couponRate and ytm are annualized percentages,
+// periods is the total number of coupon periods and freq is the number of coupon payments
+// per year (e.g. 2 for semi-annual).
+func BondPrice(face, couponRate, ytm float64, periods, freq int) float64 {
+	price := bondPriceAtYield(face, couponRate, ytm/100, periods, freq)
+	return math.Round(price*100) / 100
+}
+
+// BondYTM - Bond Yield to Maturity
+//
+// BondYTM solves for the annualized yield that discounts a bond's coupon payments and face
+// value back to price, using Newton-Raphson with the analytical derivative of BondPrice and
+// falling back to bisection on [1e-6, 1.0] if it fails to converge.
+func BondYTM(price, face, couponRate float64, periods, freq int) (float64, error) {
+	y := couponRate / 100
+	if y <= 0 {
+		y = 0.05
+	}
+
+	for i := 0; i < bondMaxIterations; i++ {
+		diff := bondPriceAtYield(face, couponRate, y, periods, freq) - price
+		if math.Abs(diff) < bondTolerance {
+			return y * 100, nil
+		}
+
+		derivative := bondPriceDerivative(face, couponRate, y, periods, freq)
+		if math.Abs(derivative) < 1e-12 {
+			break
+		}
+
+		y -= diff / derivative
+	}
+
+	root, err := bisectRoot(func(r float64) float64 {
+		return bondPriceAtYield(face, couponRate, r, periods, freq) - price
+	}, 1e-6, 1.0)
+	if err != nil {
+		return 0, errors.New("finance: BondYTM failed to converge")
+	}
+
+	return root * 100, nil
+}
+
+// bondWeightedCashFlows returns, for each coupon period, t*(t+1 if convexityWeight)*PV(CFt) summed
+// across periods, and the bond's price, shared by MacaulayDuration and Convexity. coupon is the
+// absolute coupon payment made each period.
+func bondWeightedCashFlows(face, coupon, periodRate float64, periods int, convexityWeight bool) (weightedSum, price float64) {
+	for t := 1; t <= periods; t++ {
+		cf := coupon
+		if t == periods {
+			cf += face
+		}
+
+		pv := cf / math.Pow(1+periodRate, float64(t))
+		price += pv
+
+		if convexityWeight {
+			weightedSum += float64(t) * (float64(t) + 1) * pv
+		} else {
+			weightedSum += float64(t) * pv
+		}
+	}
+
+	return weightedSum, price
+}
+
+// MacaulayDuration - Macaulay Duration
+//
+// MacaulayDuration returns the weighted average time, in years, it takes to recover a bond's
+// price from its discounted coupon and face-value cash flows.
+func MacaulayDuration(face, couponRate, ytm float64, periods, freq int) float64 {
+	m := float64(freq)
+	periodRate := ytm / 100 / m
+	coupon := face * couponRate / 100 / m
+
+	weightedSum, price := bondWeightedCashFlows(face, coupon, periodRate, periods, false)
+	duration := (weightedSum / price) / m
+
+	return math.Round(duration*10000) / 10000
+}
+
+// ModifiedDuration - Modified Duration
+//
+// ModifiedDuration adjusts MacaulayDuration for the bond's periodic yield, approximating the
+// percentage price change of a bond for a 1% change in yield.
+func ModifiedDuration(face, couponRate, ytm float64, periods, freq int) float64 {
+	m := float64(freq)
+	periodRate := ytm / 100 / m
+
+	duration := MacaulayDuration(face, couponRate, ytm, periods, freq) / (1 + periodRate)
+
+	return math.Round(duration*10000) / 10000
+}
+
+// Convexity - Bond Convexity
+//
+// Convexity measures the curvature in the relationship between a bond's price and its yield,
+// refining the linear approximation given by ModifiedDuration.
+func Convexity(face, couponRate, ytm float64, periods, freq int) float64 {
+	m := float64(freq)
+	periodRate := ytm / 100 / m
+	coupon := face * couponRate / 100 / m
+
+	weightedSum, price := bondWeightedCashFlows(face, coupon, periodRate, periods, true)
+	convexity := weightedSum / (price * math.Pow(1+periodRate, 2))
+
+	return math.Round(convexity*10000) / 10000
+}
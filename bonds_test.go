@@ -0,0 +1,86 @@
+package finance
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBondPrice(t *testing.T) {
+	t.Run("Compute BondPrice", func(t *testing.T) {
+		entries := []struct {
+			face, couponRate, ytm float64
+			periods, freq         int
+			want                  float64
+		}{
+			{1000, 6, 5, 10, 2, 1043.76},
+		}
+
+		for _, entry := range entries {
+			got := BondPrice(entry.face, entry.couponRate, entry.ytm, entry.periods, entry.freq)
+
+			if got != entry.want {
+				t.Errorf("Expected %v to be equal %v", got, entry.want)
+			}
+		}
+	})
+}
+
+func TestBondYTM(t *testing.T) {
+	t.Run("Compute BondYTM", func(t *testing.T) {
+		price := BondPrice(1000, 6, 5, 10, 2)
+
+		got, err := BondYTM(price, 1000, 6, 10, 2)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if math.Abs(got-5) > 1e-3 {
+			t.Errorf("Expected %v to be equal %v", got, 5.0)
+		}
+	})
+}
+
+func TestMacaulayAndModifiedDuration(t *testing.T) {
+	t.Run("Compute MacaulayDuration and ModifiedDuration", func(t *testing.T) {
+		entries := []struct {
+			face, couponRate, ytm float64
+			periods, freq         int
+			wantMacaulay          float64
+			wantModified          float64
+		}{
+			{1000, 6, 5, 10, 2, 4.4084, 4.3009},
+		}
+
+		for _, entry := range entries {
+			gotMacaulay := MacaulayDuration(entry.face, entry.couponRate, entry.ytm, entry.periods, entry.freq)
+			gotModified := ModifiedDuration(entry.face, entry.couponRate, entry.ytm, entry.periods, entry.freq)
+
+			if gotMacaulay != entry.wantMacaulay {
+				t.Errorf("Expected Macaulay duration %v to be equal %v", gotMacaulay, entry.wantMacaulay)
+			}
+			if gotModified != entry.wantModified {
+				t.Errorf("Expected modified duration %v to be equal %v", gotModified, entry.wantModified)
+			}
+		}
+	})
+}
+
+func TestConvexity(t *testing.T) {
+	t.Run("Compute Convexity", func(t *testing.T) {
+		entries := []struct {
+			face, couponRate, ytm float64
+			periods, freq         int
+			want                  float64
+		}{
+			{1000, 6, 5, 10, 2, 88.3162},
+		}
+
+		for _, entry := range entries {
+			got := Convexity(entry.face, entry.couponRate, entry.ytm, entry.periods, entry.freq)
+
+			if got != entry.want {
+				t.Errorf("Expected %v to be equal %v", got, entry.want)
+			}
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package finance
+
+import (
+	"math"
+)
+
+// DiscountedPaybackPeriod - Discounted Payback Period
+//
+// DiscountedPaybackPeriod is the discounted counterpart of PP: each period's cash flow is
+// discounted back to present value at rate before it is accumulated. It returns the number of
+// periods until the cumulative discounted cash flow turns non-negative, interpolated linearly
+// within the period in which that happens.
+func DiscountedPaybackPeriod(rate, initialInvestment float64, cashFlows []float64) float64 {
+	r := rate / 100
+	cumulative := initialInvestment
+	var periodsCounter float64 = 1
+
+	for i := 0; i < len(cashFlows); i++ {
+		discounted := cashFlows[i] / math.Pow(1+r, float64(i+1))
+		cumulative += discounted
+
+		if cumulative >= 0 {
+			periodsCounter += (discounted-cumulative)/discounted - 1
+			break
+		}
+
+		periodsCounter++
+	}
+
+	return periodsCounter
+}
+
+// EAA - Equivalent Annual Annuity
+//
+// Equivalent Annual Annuity (EAA) converts a project's NPV into the constant annual cash flow
+// that would produce the same NPV over periods years at rate, making projects of unequal
+// length comparable.
+func EAA(rate, npv float64, periods int) float64 {
+	r := rate / 100
+	eaa := npv * (r / (1 - math.Pow(1+r, -float64(periods))))
+
+	return math.Round(eaa*100) / 100
+}
+
+// BreakEvenPeriods - Break-Even Periods
+//
+// BreakEvenPeriods solves for the number of periods n at which the present value of an
+// annuity of periodicCashFlow at rate equals the (absolute) initialInvestment, i.e. the point
+// a project with a constant cash flow breaks even.
+func BreakEvenPeriods(rate, initialInvestment, periodicCashFlow float64) float64 {
+	r := rate / 100
+	pv := math.Abs(initialInvestment)
+
+	n := -math.Log(1-(pv*r)/periodicCashFlow) / math.Log(1+r)
+
+	return math.Round(n*100) / 100
+}
@@ -0,0 +1,68 @@
+package finance
+
+import (
+	"testing"
+)
+
+func TestDiscountedPaybackPeriod(t *testing.T) {
+	t.Run("Compute DiscountedPaybackPeriod", func(t *testing.T) {
+		entries := []struct {
+			rate              float64
+			initialInvestment float64
+			cashFlows         []float64
+			want              float64
+		}{
+			{10, -1000, []float64{300, 300, 300, 300, 300}, 4.263266666666667},
+		}
+
+		for _, entry := range entries {
+			got := DiscountedPaybackPeriod(entry.rate, entry.initialInvestment, entry.cashFlows)
+
+			if got != entry.want {
+				t.Errorf("Expected %v to be equal %v", got, entry.want)
+			}
+		}
+	})
+}
+
+func TestEAA(t *testing.T) {
+	t.Run("Compute EAA", func(t *testing.T) {
+		entries := []struct {
+			rate    float64
+			npv     float64
+			periods int
+			want    float64
+		}{
+			{10, 80015.03, 3, 32175.23},
+		}
+
+		for _, entry := range entries {
+			got := EAA(entry.rate, entry.npv, entry.periods)
+
+			if got != entry.want {
+				t.Errorf("Expected %v to be equal %v", got, entry.want)
+			}
+		}
+	})
+}
+
+func TestBreakEvenPeriods(t *testing.T) {
+	t.Run("Compute BreakEvenPeriods", func(t *testing.T) {
+		entries := []struct {
+			rate              float64
+			initialInvestment float64
+			periodicCashFlow  float64
+			want              float64
+		}{
+			{10, -1000, 200, 7.27},
+		}
+
+		for _, entry := range entries {
+			got := BreakEvenPeriods(entry.rate, entry.initialInvestment, entry.periodicCashFlow)
+
+			if got != entry.want {
+				t.Errorf("Expected %v to be equal %v", got, entry.want)
+			}
+		}
+	})
+}
@@ -0,0 +1,160 @@
+package finance
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// DatedCashFlow is a cash flow that occurs on a specific date, for use with XNPV and XIRR
+// where, unlike NPV and PP, flows are not assumed to be evenly spaced.
+type DatedCashFlow struct {
+	Date   time.Time
+	Amount float64
+}
+
+// DayCount selects how the fraction of a year between two dates is computed.
+type DayCount int
+
+const (
+	// Act365 divides the actual number of days between dates by 365.
+	Act365 DayCount = iota
+	// Act360 divides the actual number of days between dates by 360.
+	Act360
+	// ActAct divides the actual number of days between dates by the number of days in the
+	// calendar year the start date falls in (365, or 366 in a leap year).
+	ActAct
+	// Thirty360 treats every month as having 30 days and every year as having 360 days.
+	Thirty360
+)
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+func thirty360Days(start, end time.Time) float64 {
+	d1, m1, y1 := start.Day(), int(start.Month()), start.Year()
+	d2, m2, y2 := end.Day(), int(end.Month()), end.Year()
+
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 && d1 == 30 {
+		d2 = 30
+	}
+
+	return float64((y2-y1)*360 + (m2-m1)*30 + (d2 - d1))
+}
+
+// yearFraction returns the fraction of a year between start and end under the day-count
+// convention d.
+func (d DayCount) yearFraction(start, end time.Time) float64 {
+	actualDays := end.Sub(start).Hours() / 24
+
+	switch d {
+	case Act360:
+		return actualDays / 360
+	case ActAct:
+		daysInYear := 365.0
+		if isLeapYear(start.Year()) {
+			daysInYear = 366.0
+		}
+		return actualDays / daysInYear
+	case Thirty360:
+		return thirty360Days(start, end) / 360
+	default:
+		return actualDays / 365
+	}
+}
+
+// xnpvAtRate computes XNPV with rate already expressed as a fraction, for use by the
+// Newton-Raphson/bisection solver in XIRR, which works in fraction space internally.
+func xnpvAtRate(rate float64, flows []DatedCashFlow, dayCount DayCount) float64 {
+	if len(flows) == 0 {
+		return 0
+	}
+
+	base := flows[0].Date
+	npv := 0.0
+
+	for _, flow := range flows {
+		t := dayCount.yearFraction(base, flow.Date)
+		npv += flow.Amount / math.Pow(1+rate, t)
+	}
+
+	return npv
+}
+
+// XNPV - Net Present Value of dated cash flows
+//
+// XNPV discounts each flow back to the date of the first flow by the fraction of a year
+// between them, under dayCount: XNPV = Σ CFᵢ/(1+rate)^((dateᵢ−date₀)/basis). Unlike NPV, the
+// flows need not be evenly spaced. Like Rate, PV and NPV, rate is a percentage (e.g. 9 for 9%).
+func XNPV(rate float64, flows []DatedCashFlow, dayCount DayCount) float64 {
+	return xnpvAtRate(rate/100, flows, dayCount)
+}
+
+func xnpvDerivative(rate float64, flows []DatedCashFlow, dayCount DayCount) float64 {
+	if len(flows) == 0 {
+		return 0
+	}
+
+	base := flows[0].Date
+	derivative := 0.0
+
+	for _, flow := range flows {
+		t := dayCount.yearFraction(base, flow.Date)
+		if t == 0 {
+			continue
+		}
+		derivative += -t * flow.Amount / math.Pow(1+rate, t+1)
+	}
+
+	return derivative
+}
+
+// XIRR - Internal Rate of Return of dated cash flows
+//
+// XIRR solves for the annualized rate that makes XNPV(rate, flows, dayCount) equal to zero,
+// starting from guess. It uses Newton-Raphson and falls back to bisection on [-0.999, 10] if
+// Newton-Raphson fails to converge or its derivative becomes too small. flows must contain at
+// least two cash flows and a sign change, otherwise an error is returned. Like Rate, guess and
+// the result are percentages (e.g. 10 for 10%), matching XNPV.
+func XIRR(flows []DatedCashFlow, guess float64, dayCount DayCount) (float64, error) {
+	if len(flows) < 2 {
+		return 0, errors.New("finance: XIRR requires at least two cash flows")
+	}
+
+	amounts := make([]float64, len(flows))
+	for i, flow := range flows {
+		amounts[i] = flow.Amount
+	}
+	if !hasSignChange(amounts) {
+		return 0, errors.New("finance: XIRR requires a sign change in flows")
+	}
+
+	rate := guess / 100
+
+	for i := 0; i < irrMaxIterations; i++ {
+		f := xnpvAtRate(rate, flows, dayCount)
+		if math.Abs(f) < irrTolerance {
+			return rate * 100, nil
+		}
+
+		df := xnpvDerivative(rate, flows, dayCount)
+		if math.Abs(df) < 1e-12 {
+			break
+		}
+
+		rate -= f / df
+	}
+
+	root, err := bisectRoot(func(r float64) float64 {
+		return xnpvAtRate(r, flows, dayCount)
+	}, -0.999, 10)
+	if err != nil {
+		return 0, err
+	}
+
+	return root * 100, nil
+}
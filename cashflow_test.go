@@ -0,0 +1,64 @@
+package finance
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func sampleCashFlows() []DatedCashFlow {
+	return []DatedCashFlow{
+		{Date: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), Amount: -10000},
+		{Date: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Amount: 2750},
+		{Date: time.Date(2020, 10, 30, 0, 0, 0, 0, time.UTC), Amount: 4250},
+		{Date: time.Date(2021, 2, 15, 0, 0, 0, 0, time.UTC), Amount: 3250},
+		{Date: time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC), Amount: 2750},
+	}
+}
+
+func TestXNPV(t *testing.T) {
+	t.Run("Compute XNPV", func(t *testing.T) {
+		got := XNPV(9, sampleCashFlows(), Act365)
+		want := 2086.65
+
+		if math.Abs(got-want) > 1e-2 {
+			t.Errorf("Expected %v to be equal %v", got, want)
+		}
+	})
+
+	t.Run("Returns 0 for no flows", func(t *testing.T) {
+		if got := XNPV(10, nil, Act365); got != 0 {
+			t.Errorf("Expected 0, got %v", got)
+		}
+	})
+}
+
+func TestXIRR(t *testing.T) {
+	t.Run("Compute XIRR", func(t *testing.T) {
+		got, err := XIRR(sampleCashFlows(), 10, Act365)
+
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if math.Abs(got-37.336253) > 1e-4 {
+			t.Errorf("Expected %v to be equal %v", got, 37.336253)
+		}
+	})
+
+	t.Run("Returns error for too few flows", func(t *testing.T) {
+		if _, err := XIRR(sampleCashFlows()[:1], 10, Act365); err == nil {
+			t.Error("Expected an error, got nil")
+		}
+	})
+
+	t.Run("Returns error when there is no sign change", func(t *testing.T) {
+		flows := []DatedCashFlow{
+			{Date: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), Amount: 100},
+			{Date: time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), Amount: 200},
+		}
+
+		if _, err := XIRR(flows, 10, Act365); err == nil {
+			t.Error("Expected an error, got nil")
+		}
+	})
+}
@@ -0,0 +1,219 @@
+// Package financedec mirrors the primary entry points of the finance package using
+// decimal.Decimal instead of float64, so that callers doing real money math avoid the
+// rounding drift and non-associative addition that come with binary floating point.
+package financedec
+
+import (
+	"github.com/fernandoporazzi/finance"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	one     = decimal.NewFromInt(1)
+	twelve  = decimal.NewFromInt(12)
+	hundred = decimal.NewFromInt(100)
+)
+
+// FromFloat converts a float64 into a decimal.Decimal, for callers bridging from the float64 API.
+func FromFloat(f float64) decimal.Decimal {
+	return decimal.NewFromFloat(f)
+}
+
+// ToFloat converts a decimal.Decimal back into a float64.
+func ToFloat(d decimal.Decimal) float64 {
+	f, _ := d.Float64()
+	return f
+}
+
+// Round rounds d to the given number of decimal places. Unlike the finance package, where
+// most functions round their results internally, rounding here is explicit so callers control precision
+// and can defer it until the end of a computation.
+func Round(d decimal.Decimal, places int32) decimal.Decimal {
+	return d.Round(places)
+}
+
+// PV - Present Value
+//
+// See finance.PV. rate is a percentage (e.g. 5 for 5%); the result is not rounded.
+func PV(rate, cashFlow, period decimal.Decimal) decimal.Decimal {
+	r := rate.Div(hundred)
+	return cashFlow.Div(one.Add(r).Pow(period))
+}
+
+// FV - Future Value
+//
+// See finance.FV. rate is a percentage (e.g. 5 for 5%); the result is not rounded.
+func FV(rate, cashFlow, period decimal.Decimal) decimal.Decimal {
+	r := rate.Div(hundred)
+	return cashFlow.Mul(one.Add(r).Pow(period))
+}
+
+// NPV - Net Present Value
+//
+// See finance.NPV. rate is a percentage (e.g. 10 for 10%); the result is not rounded.
+func NPV(rate, initialInvestment decimal.Decimal, cashFlows []decimal.Decimal) decimal.Decimal {
+	r := rate.Div(hundred)
+	npv := initialInvestment
+
+	for i, cf := range cashFlows {
+		period := decimal.NewFromInt(int64(i + 1))
+		npv = npv.Add(cf.Div(one.Add(r).Pow(period)))
+	}
+
+	return npv
+}
+
+// PMT - Payment
+//
+// See finance.PMT. rate is a percentage (e.g. 2 for 2%); the result is not rounded.
+func PMT(rate, numOfPayments, principal decimal.Decimal) decimal.Decimal {
+	r := rate.Div(hundred)
+	denominator := one.Sub(one.Add(r).Pow(numOfPayments.Neg()))
+
+	return principal.Mul(r).Neg().Div(denominator)
+}
+
+// amortizationTerms validates paymentType and converts rate and period into the periodic
+// interest rate and total number of periods shared by AM and AmortizationSchedule.
+func amortizationTerms(rate, period decimal.Decimal, paymentType finance.PaymentType) (ratePerPeriod, totalPeriods decimal.Decimal) {
+	if paymentType != finance.Years && paymentType != finance.Months {
+		panic("paymentType should be either Months or Years")
+	}
+
+	ratePerPeriod = rate.Div(twelve).Div(hundred)
+
+	totalPeriods = period
+	if paymentType == finance.Years {
+		totalPeriods = period.Mul(twelve)
+	}
+
+	return ratePerPeriod, totalPeriods
+}
+
+// AM - Amortization
+//
+// See finance.AM. paymentType can be either finance.Years or finance.Months; the result is
+// not rounded.
+func AM(principal, rate, period decimal.Decimal, paymentType finance.PaymentType, payAtBeginning bool) decimal.Decimal {
+	ratePerPeriod, totalPeriods := amortizationTerms(rate, period, paymentType)
+
+	numInterestAccruals := totalPeriods
+	if payAtBeginning {
+		numInterestAccruals = numInterestAccruals.Sub(one)
+	}
+
+	numerator := ratePerPeriod.Mul(one.Add(ratePerPeriod).Pow(numInterestAccruals))
+	denominator := one.Add(ratePerPeriod).Pow(totalPeriods).Sub(one)
+
+	return principal.Mul(numerator.Div(denominator))
+}
+
+// CI - Compound Interest
+//
+// See finance.CI. The result is not rounded.
+func CI(rate, numOfCompoundings, principal, numOfPeriods decimal.Decimal) decimal.Decimal {
+	r := rate.Div(hundred).Div(numOfCompoundings)
+	exponent := numOfCompoundings.Mul(numOfPeriods)
+
+	return principal.Mul(one.Add(r).Pow(exponent))
+}
+
+// CAGR - Compound Annual Growth Rate
+//
+// See finance.CAGR. Unlike finance.CAGR, the result is a fraction (e.g. 0.25, not 25) and is
+// not rounded; multiply by 100 and Round to match finance.CAGR's output.
+func CAGR(beginningValue, endingValue, numOfPeriods decimal.Decimal) decimal.Decimal {
+	ratio := endingValue.Div(beginningValue)
+	return ratio.Pow(one.Div(numOfPeriods)).Sub(one)
+}
+
+// DF - Discount Factor
+//
+// See finance.DF. rate is a percentage (e.g. 10 for 10%); the results are not rounded.
+// numOfPeriods must be at least 1.
+func DF(rate decimal.Decimal, numOfPeriods int) []decimal.Decimal {
+	if numOfPeriods < 1 {
+		panic("numOfPeriods must be at least 1")
+	}
+
+	r := rate.Div(hundred)
+	dfs := make([]decimal.Decimal, numOfPeriods-1)
+
+	for i := 1; i < numOfPeriods; i++ {
+		exponent := decimal.NewFromInt(int64(i - 1))
+		dfs[i-1] = one.Div(one.Add(r).Pow(exponent))
+	}
+
+	return dfs
+}
+
+// AmortizationRow mirrors finance.AmortizationRow with decimal.Decimal fields. As with the
+// rest of this package, values are not rounded internally; call Round on the fields you
+// display.
+type AmortizationRow struct {
+	Period    int
+	Payment   decimal.Decimal
+	Interest  decimal.Decimal
+	Principal decimal.Decimal
+	Balance   decimal.Decimal
+}
+
+// AmortizationSchedule - Amortization Schedule
+//
+// AmortizationSchedule mirrors finance.AmortizationSchedule, walking the loan balance in
+// decimal.Decimal so it never drifts: the final period's principal component is set to
+// whatever balance remains, rather than payment minus interest, guaranteeing the balance
+// reaches exactly zero and the principal components sum back to exactly principal regardless
+// of how many digits any single period's division carries.
+func AmortizationSchedule(principal, rate, period decimal.Decimal, paymentType finance.PaymentType, payAtBeginning bool) []AmortizationRow {
+	ratePerPeriod, totalPeriods := amortizationTerms(rate, period, paymentType)
+	payment := AM(principal, rate, period, paymentType, payAtBeginning)
+	nper := int(totalPeriods.IntPart())
+
+	rows := make([]AmortizationRow, 0, nper)
+	balance := principal
+
+	for p := 1; p <= nper; p++ {
+		interest := decimal.Zero
+		if !(payAtBeginning && p == 1) {
+			interest = balance.Mul(ratePerPeriod)
+		}
+
+		principalComponent := payment.Sub(interest)
+		if p == nper {
+			principalComponent = balance
+		}
+
+		balance = balance.Sub(principalComponent)
+
+		rows = append(rows, AmortizationRow{
+			Period:    p,
+			Payment:   payment,
+			Interest:  interest,
+			Principal: principalComponent,
+			Balance:   balance,
+		})
+	}
+
+	return rows
+}
+
+// IRR - Internal Rate of Return
+//
+// IRR mirrors finance.IRR, converting cashFlows to float64 to run the underlying
+// Newton-Raphson/bisection solver and converting the result back, since root-finding has no
+// exact decimal analogue. The result is a percentage (e.g. 28.094842 for 28.094842%), matching
+// PV, FV, NPV and AM above.
+func IRR(cashFlows []decimal.Decimal) (decimal.Decimal, error) {
+	floatFlows := make([]float64, len(cashFlows))
+	for i, cf := range cashFlows {
+		floatFlows[i] = ToFloat(cf)
+	}
+
+	rate, err := finance.IRR(floatFlows)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return FromFloat(rate), nil
+}
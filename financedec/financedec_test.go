@@ -0,0 +1,135 @@
+package financedec
+
+import (
+	"testing"
+
+	"github.com/fernandoporazzi/finance"
+	"github.com/shopspring/decimal"
+)
+
+func TestPV(t *testing.T) {
+	t.Run("Compute PV", func(t *testing.T) {
+		got := PV(FromFloat(5), FromFloat(100), FromFloat(1))
+		want := FromFloat(95.24)
+
+		if !Round(got, 2).Equal(want) {
+			t.Errorf("Expected %v to be equal %v", got, want)
+		}
+	})
+}
+
+func TestAM(t *testing.T) {
+	t.Run("Compute AM", func(t *testing.T) {
+		got := AM(FromFloat(20000), FromFloat(7.5), FromFloat(5), finance.Years, false)
+		want := FromFloat(400.76)
+
+		if !Round(got, 2).Equal(want) {
+			t.Errorf("Expected %v to be equal %v", got, want)
+		}
+	})
+
+	t.Run("Panics on invalid paymentType", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected a panic for an invalid paymentType")
+			}
+		}()
+
+		AM(FromFloat(20000), FromFloat(7.5), FromFloat(5), finance.PaymentType(99), false)
+	})
+}
+
+func TestNPV(t *testing.T) {
+	t.Run("Compute NPV", func(t *testing.T) {
+		cashFlows := []decimal.Decimal{FromFloat(200000), FromFloat(300000), FromFloat(200000)}
+
+		got := NPV(FromFloat(10), FromFloat(-500000), cashFlows)
+		want := FromFloat(80015.03)
+
+		if !Round(got, 2).Equal(want) {
+			t.Errorf("Expected %v to be equal %v", got, want)
+		}
+	})
+}
+
+func TestIRR(t *testing.T) {
+	t.Run("Compute IRR", func(t *testing.T) {
+		cashFlows := []decimal.Decimal{FromFloat(-100), FromFloat(39), FromFloat(59), FromFloat(55), FromFloat(20)}
+
+		got, err := IRR(cashFlows)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		want := FromFloat(28.094842)
+		if ToFloat(got.Sub(want).Abs()) > 1e-4 {
+			t.Errorf("Expected %v to be equal %v", got, want)
+		}
+	})
+}
+
+func TestDF(t *testing.T) {
+	t.Run("Compute DF", func(t *testing.T) {
+		got := DF(FromFloat(10), 6)
+		want := []decimal.Decimal{FromFloat(1), FromFloat(0.9091), FromFloat(0.8264), FromFloat(0.7513), FromFloat(0.683)}
+
+		for i, v := range got {
+			if !Round(v, 4).Equal(want[i]) {
+				t.Errorf("Expected %v to be equal %v", v, want[i])
+			}
+		}
+	})
+}
+
+func TestDFPanicsOnInvalidPeriods(t *testing.T) {
+	t.Run("Panics when numOfPeriods is less than 1", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected a panic for numOfPeriods < 1")
+			}
+		}()
+
+		DF(FromFloat(10), 0)
+	})
+}
+
+func TestAmortizationSchedule(t *testing.T) {
+	t.Run("Principal components sum back to exactly principal", func(t *testing.T) {
+		principal := FromFloat(20000)
+		rows := AmortizationSchedule(principal, FromFloat(7.5), FromFloat(5), finance.Years, false)
+
+		if len(rows) != 60 {
+			t.Fatalf("Expected 60 rows, got %v", len(rows))
+		}
+
+		sum := decimal.Zero
+		for _, row := range rows {
+			sum = sum.Add(row.Principal)
+		}
+
+		if !sum.Equal(principal) {
+			t.Errorf("Expected principal components to sum to %v, got %v", principal, sum)
+		}
+		if !rows[59].Balance.Equal(decimal.Zero) {
+			t.Errorf("Expected final balance to be exactly 0, got %v", rows[59].Balance)
+		}
+	})
+
+	t.Run("Panics on invalid paymentType", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("Expected a panic for an invalid paymentType")
+			}
+		}()
+
+		AmortizationSchedule(FromFloat(20000), FromFloat(7.5), FromFloat(5), finance.PaymentType(99), false)
+	})
+}
+
+func TestFromFloatToFloat(t *testing.T) {
+	t.Run("Round-trips through decimal.Decimal", func(t *testing.T) {
+		if ToFloat(FromFloat(123.45)) != 123.45 {
+			t.Errorf("Expected FromFloat/ToFloat to round-trip 123.45")
+		}
+	})
+}
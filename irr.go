@@ -0,0 +1,165 @@
+package finance
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	irrMaxIterations = 50
+	irrTolerance     = 1e-9
+	irrInitialGuess  = 0.1
+)
+
+// npvAtRate returns the net present value of cashFlows discounted at rate,
+// treating cashFlows[i] as occurring at period i.
+func npvAtRate(rate float64, cashFlows []float64) float64 {
+	npv := 0.0
+
+	for i, cf := range cashFlows {
+		npv += cf / math.Pow(1+rate, float64(i))
+	}
+
+	return npv
+}
+
+// npvDerivativeAtRate returns d/dr of npvAtRate, used by Newton-Raphson.
+func npvDerivativeAtRate(rate float64, cashFlows []float64) float64 {
+	derivative := 0.0
+
+	for i, cf := range cashFlows {
+		if i == 0 {
+			continue
+		}
+
+		derivative += -float64(i) * cf / math.Pow(1+rate, float64(i+1))
+	}
+
+	return derivative
+}
+
+func hasSignChange(cashFlows []float64) bool {
+	positive, negative := false, false
+
+	for _, cf := range cashFlows {
+		if cf > 0 {
+			positive = true
+		}
+		if cf < 0 {
+			negative = true
+		}
+	}
+
+	return positive && negative
+}
+
+// bisectRoot finds a root of f on [low, high] assuming a sign change between the bounds.
+func bisectRoot(f func(float64) float64, low, high float64) (float64, error) {
+	fLow, fHigh := f(low), f(high)
+
+	if fLow == 0 {
+		return low, nil
+	}
+	if fHigh == 0 {
+		return high, nil
+	}
+	if fLow*fHigh > 0 {
+		return 0, errors.New("finance: failed to converge, no sign change on bisection interval")
+	}
+
+	for i := 0; i < irrMaxIterations; i++ {
+		mid := (low + high) / 2
+		fMid := f(mid)
+
+		if math.Abs(fMid) < irrTolerance {
+			return mid, nil
+		}
+
+		if fLow*fMid < 0 {
+			high = mid
+		} else {
+			low, fLow = mid, fMid
+		}
+	}
+
+	return (low + high) / 2, nil
+}
+
+// IRR - Internal Rate of Return
+//
+// Internal Rate of Return (IRR) is the discount rate that makes the net present value of
+// cashFlows equal to zero. cashFlows[0] is typically a negative initial investment followed
+// by the projected returns. It is solved with Newton-Raphson and falls back to bisection on
+// [-0.999, 10] if Newton-Raphson fails to converge or its derivative becomes too small.
+// cashFlows must contain at least two periods and a sign change, otherwise an error is returned.
+// Like Rate, PV, FV and NPV, the result is a percentage (e.g. 28.094842 for 28.094842%), not
+// a fraction, so it can be fed straight back into NPV/PV.
+func IRR(cashFlows []float64) (float64, error) {
+	if len(cashFlows) < 2 {
+		return 0, errors.New("finance: IRR requires at least two periods")
+	}
+	if !hasSignChange(cashFlows) {
+		return 0, errors.New("finance: IRR requires a sign change in cashFlows")
+	}
+
+	rate := irrInitialGuess
+
+	for i := 0; i < irrMaxIterations; i++ {
+		f := npvAtRate(rate, cashFlows)
+		if math.Abs(f) < irrTolerance {
+			return rate * 100, nil
+		}
+
+		df := npvDerivativeAtRate(rate, cashFlows)
+		if math.Abs(df) < 1e-12 {
+			break
+		}
+
+		rate -= f / df
+	}
+
+	root, err := bisectRoot(func(r float64) float64 {
+		return npvAtRate(r, cashFlows)
+	}, -0.999, 10)
+	if err != nil {
+		return 0, err
+	}
+
+	return root * 100, nil
+}
+
+// MIRR - Modified Internal Rate of Return
+//
+// Modified Internal Rate of Return (MIRR) addresses IRR's tendency to produce multiple or
+// unrealistic roots by assuming positive cash flows are reinvested at reinvestRate and that
+// negative cash flows are financed at financeRate. cashFlows must contain at least two periods
+// and a sign change, otherwise an error is returned. financeRate, reinvestRate and the result
+// are all percentages (e.g. 8 for 8%), matching Rate, PV, FV and NPV.
+func MIRR(cashFlows []float64, financeRate, reinvestRate float64) (float64, error) {
+	n := len(cashFlows)
+
+	if n < 2 {
+		return 0, errors.New("finance: MIRR requires at least two periods")
+	}
+	if !hasSignChange(cashFlows) {
+		return 0, errors.New("finance: MIRR requires a sign change in cashFlows")
+	}
+
+	finRate := financeRate / 100
+	reinvRate := reinvestRate / 100
+
+	var futureValue, presentValue float64
+
+	for i, cf := range cashFlows {
+		switch {
+		case cf > 0:
+			futureValue += cf * math.Pow(1+reinvRate, float64(n-1-i))
+		case cf < 0:
+			presentValue += cf / math.Pow(1+finRate, float64(i))
+		}
+	}
+
+	mirr := math.Pow(futureValue/math.Abs(presentValue), 1/float64(n-1)) - 1
+
+	return mirr * 100, nil
+}
@@ -0,0 +1,76 @@
+package finance
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIRR(t *testing.T) {
+	t.Run("Compute IRR", func(t *testing.T) {
+		entries := []struct {
+			cashFlows []float64
+			want      float64
+		}{
+			{[]float64{-100, 39, 59, 55, 20}, 28.094842},
+			{[]float64{-5000, 1200, 1200, 1200, 1200, 1200}, 6.402241},
+		}
+
+		for _, entry := range entries {
+			got, err := IRR(entry.cashFlows)
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if math.Abs(got-entry.want) > 1e-4 {
+				t.Errorf("Expected %v to be equal %v", got, entry.want)
+			}
+		}
+	})
+
+	t.Run("Returns error for too few periods", func(t *testing.T) {
+		if _, err := IRR([]float64{-100}); err == nil {
+			t.Error("Expected an error, got nil")
+		}
+	})
+
+	t.Run("Returns error when there is no sign change", func(t *testing.T) {
+		if _, err := IRR([]float64{100, 200, 300}); err == nil {
+			t.Error("Expected an error, got nil")
+		}
+	})
+}
+
+func TestMIRR(t *testing.T) {
+	t.Run("Compute MIRR", func(t *testing.T) {
+		entries := []struct {
+			cashFlows                 []float64
+			financeRate, reinvestRate float64
+			want                      float64
+		}{
+			{[]float64{-4500, -800, 800, 800, 3000}, 8, 5.5, -2.508102},
+		}
+
+		for _, entry := range entries {
+			got, err := MIRR(entry.cashFlows, entry.financeRate, entry.reinvestRate)
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if math.Abs(got-entry.want) > 1e-4 {
+				t.Errorf("Expected %v to be equal %v", got, entry.want)
+			}
+		}
+	})
+
+	t.Run("Returns error for too few periods", func(t *testing.T) {
+		if _, err := MIRR([]float64{-100}, 8, 5.5); err == nil {
+			t.Error("Expected an error, got nil")
+		}
+	})
+
+	t.Run("Returns error when there is no sign change", func(t *testing.T) {
+		if _, err := MIRR([]float64{100, 200, 300}, 8, 5.5); err == nil {
+			t.Error("Expected an error, got nil")
+		}
+	})
+}
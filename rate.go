@@ -0,0 +1,60 @@
+package finance
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	rateMaxIterations = 100
+	rateTolerance     = 1e-6
+)
+
+// rateG returns the value of the annuity equation pv*(1+r)^n + pmt*(1+r*when)*((1+r)^n-1)/r + fv
+// and its derivative with respect to r, used by Newton-Raphson in Rate.
+func rateG(r, nper, pmt, pv, fv float64, when float64) (float64, float64) {
+	t1 := math.Pow(1+r, nper)
+	t2 := math.Pow(1+r, nper-1)
+
+	g := pv*t1 + pmt*(t1-1)*(r*when+1)/r + fv
+	gp := nper*pv*t2 -
+		pmt*(t1-1)*(r*when+1)/(r*r) +
+		nper*pmt*t2*(r*when+1)/r +
+		pmt*(t1-1)*when/r
+
+	return g, gp
+}
+
+// Rate solves for the periodic interest rate implied by an annuity.
+//
+// Rate finds r such that pv*(1+r)^nper + pmt*(1+r*when)*((1+r)^nper-1)/r + fv = 0, using
+// Newton-Raphson with the analytic derivative starting from guess (also a percentage, e.g.
+// 10 for 10%). payAtBeginning treats payments as made at the start of each period (when = 1)
+// rather than at the end, like AM's payAtBeginning. The result is a periodic percentage rather
+// than a fraction, so it can be fed straight back into PV, FV and PMT, which all expect a
+// periodic rate as a percentage. It returns an error if the iteration
+// does not converge within 100 steps to a tolerance of 1e-6.
+func Rate(nper, pmt, pv, fv float64, payAtBeginning bool, guess float64) (float64, error) {
+	var when float64
+	if payAtBeginning {
+		when = 1
+	}
+
+	r := guess / 100
+
+	for i := 0; i < rateMaxIterations; i++ {
+		g, gp := rateG(r, nper, pmt, pv, fv, when)
+		if gp == 0 {
+			return 0, errors.New("finance: Rate failed to converge")
+		}
+
+		next := r - g/gp
+		if math.Abs(next-r) < rateTolerance {
+			return next * 100, nil
+		}
+
+		r = next
+	}
+
+	return 0, errors.New("finance: Rate failed to converge")
+}
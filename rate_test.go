@@ -0,0 +1,37 @@
+package finance
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRate(t *testing.T) {
+	t.Run("Compute Rate", func(t *testing.T) {
+		entries := []struct {
+			nper, pmt, pv, fv float64
+			payAtBeginning    bool
+			guess             float64
+			want              float64
+		}{
+			{10, 0, -3500, 10000, false, 10, 11.069085},
+			{12, -100, 1000, 0, false, 10, 2.922854},
+		}
+
+		for _, entry := range entries {
+			got, err := Rate(entry.nper, entry.pmt, entry.pv, entry.fv, entry.payAtBeginning, entry.guess)
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if math.Abs(got-entry.want) > 1e-4 {
+				t.Errorf("Expected %v to be equal %v", got, entry.want)
+			}
+		}
+	})
+
+	t.Run("Returns error when it fails to converge", func(t *testing.T) {
+		if _, err := Rate(0, 10, 100, -50, false, 10); err == nil {
+			t.Error("Expected an error, got nil")
+		}
+	})
+}